@@ -4,11 +4,16 @@
 package ra
 
 import (
+	"crypto/tls"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
 	"net/netip"
 	"os"
+	"path/filepath"
+	"sort"
+	"strings"
 
 	"github.com/creasty/defaults"
 	"github.com/go-playground/validator/v10"
@@ -21,6 +26,79 @@ type Config struct {
 	// unique within the slice. The slice itself and elements must not be
 	// nil.
 	Interfaces []*InterfaceConfig `yaml:"interfaces" json:"interfaces" validate:"required,non_nil_and_unique_name,dive,required"`
+
+	// Configuration of the management API (gRPC/HTTP). Optional; the
+	// management API is disabled when nil.
+	Management *ManagementConfig `yaml:"management" json:"management" validate:"omitempty"`
+
+	// Logging configuration. Optional; defaults to text-formatted,
+	// info-level logging to stderr.
+	Logging *LoggingConfig `yaml:"logging" json:"logging" validate:"omitempty"`
+}
+
+// LoggingConfig represents the logging configuration of the daemon.
+type LoggingConfig struct {
+	// Output format of the default stderr sink, one of "json" or "text".
+	// Default is "text". Ignored when Syslog is set.
+	Format string `yaml:"format" json:"format" validate:"oneof=json text" default:"text"`
+
+	// Minimum level of records to emit, one of "debug", "info", "warn"
+	// or "error". Default is "info".
+	Level string `yaml:"level" json:"level" validate:"oneof=debug info warn error" default:"info"`
+
+	// When set, log records are written to syslog instead of stderr.
+	Syslog *SyslogConfig `yaml:"syslog" json:"syslog" validate:"omitempty"`
+}
+
+// SyslogConfig represents the configuration of the syslog logging sink.
+// Only supported on Unix; the log package's New returns an error if
+// Syslog is set on Windows.
+type SyslogConfig struct {
+	// Network to dial, e.g. "udp" or "unixgram". Defaults to the local
+	// syslog daemon when empty, same as log/syslog.Dial.
+	Network string `yaml:"network" json:"network"`
+
+	// Address to dial. Defaults to the local syslog daemon when empty,
+	// same as log/syslog.Dial.
+	Address string `yaml:"address" json:"address"`
+
+	// Tag prepended to every log message. Default is "go-ra".
+	Tag string `yaml:"tag" json:"tag" default:"go-ra"`
+
+	// Syslog facility to log under. Default is "daemon".
+	Facility string `yaml:"facility" json:"facility" validate:"oneof=kern user mail daemon auth syslog lpr news uucp cron authpriv ftp local0 local1 local2 local3 local4 local5 local6 local7" default:"daemon"`
+}
+
+// ManagementConfig represents the configuration of the management API.
+type ManagementConfig struct {
+	// TLS configuration for the management API. Optional; the management
+	// API is served over plaintext when nil.
+	TLS *TLSConfig `yaml:"tls" json:"tls" validate:"omitempty"`
+}
+
+// TLSConfig represents the TLS configuration of the management API.
+type TLSConfig struct {
+	// Required: Path to the PEM-encoded server certificate.
+	CertFile string `yaml:"certFile" json:"certFile" validate:"required"`
+
+	// Required: Path to the PEM-encoded server private key.
+	KeyFile string `yaml:"keyFile" json:"keyFile" validate:"required"`
+
+	// Path to a PEM-encoded CA bundle used to verify client certificates.
+	// When set, the management API requires and verifies a client
+	// certificate (mTLS).
+	ClientCAFile string `yaml:"clientCAFile" json:"clientCAFile"`
+
+	// Minimum TLS version to accept, one of "1.2" or "1.3". Default is
+	// "1.2".
+	MinVersion string `yaml:"minVersion" json:"minVersion" validate:"oneof=1.2 1.3" default:"1.2"`
+
+	// Cipher suites to accept, by their crypto/tls name (e.g.
+	// "TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256"). Must be empty when
+	// MinVersion is "1.3", since TLS 1.3 cipher suites are not
+	// user-configurable in crypto/tls. Defaults to Go's own default
+	// selection when empty.
+	CipherSuites []string `yaml:"cipherSuites" json:"cipherSuites" validate:"no_cipher_suites_for_tls13,dive,valid_tls_cipher_suite_name"`
 }
 
 // InterfaceConfig represents the interface-specific configuration parameters
@@ -72,6 +150,62 @@ type InterfaceConfig struct {
 	// non-overlapping with each other. The slice itself and elements must
 	// not be nil.
 	Prefixes []*PrefixConfig `yaml:"prefixes" json:"prefixes" validate:"non_nil_and_non_overlapping_prefix,dive,required"`
+
+	// Recursive DNS Server (RFC 8106) options to advertise. The slice
+	// elements must not be nil.
+	RDNSS []*RDNSSConfig `yaml:"rdnss" json:"rdnss" validate:"dive,required"`
+
+	// DNS Search List (RFC 8106) options to advertise. The slice elements
+	// must not be nil.
+	DNSSL []*DNSSLConfig `yaml:"dnssl" json:"dnssl" validate:"dive,required"`
+
+	// Route Information (RFC 4191) options to advertise. The prefix
+	// fields must be non-overlapping with each other. The slice itself
+	// and elements must not be nil.
+	Routes []*RouteConfig `yaml:"routes" json:"routes" validate:"non_nil_and_non_overlapping_route,dive,required"`
+}
+
+// RDNSSConfig represents a Recursive DNS Server (RFC 8106) option
+type RDNSSConfig struct {
+	// Required: Addresses of the recursive DNS servers, in the order they
+	// should be advertised. Must be valid IPv6 addresses.
+	Addresses []string `yaml:"addresses" json:"addresses" validate:"required,dive,ip6_addr"`
+
+	// The maximum time, in seconds, that the addresses may be used for
+	// name resolution. Must be >= 0 and <= 4294967295. Default is 2 *
+	// RAIntervalMilliseconds/1000, as recommended by RFC8106 section
+	// 5.1. If set to 4294967295, it indicates infinity.
+	LifetimeSeconds *int `yaml:"lifetimeSeconds" json:"lifetimeSeconds" validate:"omitempty,gte=0,lte=4294967295"`
+}
+
+// DNSSLConfig represents a DNS Search List (RFC 8106) option
+type DNSSLConfig struct {
+	// Required: DNS search domain names, in the order they should be
+	// advertised. Each label must be <= 63 octets and the full name must
+	// be <= 255 octets, per RFC1035.
+	DomainNames []string `yaml:"domainNames" json:"domainNames" validate:"required,dive,valid_dns_search_domain"`
+
+	// The maximum time, in seconds, that the domain names may be used
+	// for name resolution. Must be >= 0 and <= 4294967295. Default is 2
+	// * RAIntervalMilliseconds/1000, as recommended by RFC8106 section
+	// 5.1. If set to 4294967295, it indicates infinity.
+	LifetimeSeconds *int `yaml:"lifetimeSeconds" json:"lifetimeSeconds" validate:"omitempty,gte=0,lte=4294967295"`
+}
+
+// RouteConfig represents a Route Information (RFC 4191) option
+type RouteConfig struct {
+	// Required: Prefix. Must be a valid IPv6 prefix.
+	Prefix string `yaml:"prefix" json:"prefix" validate:"required,cidrv6"`
+
+	// Preference of the route relative to other routes advertised by
+	// other routers. One of "low", "medium" or "high". Default is
+	// "medium".
+	Preference string `yaml:"preference" json:"preference" validate:"oneof=low medium high" default:"medium"`
+
+	// The length of time, in seconds, that the prefix is valid for
+	// route determination. Must be >= 0 and <= 4294967295. Default is
+	// 2592000 (30 days). If set to 4294967295, it indicates infinity.
+	LifetimeSeconds *int `yaml:"lifetimeSeconds" json:"lifetimeSeconds" validate:"required,gte=0,lte=4294967295" default:"2592000"`
 }
 
 // PrefixConfig represents the prefix-specific configuration parameters
@@ -102,6 +236,31 @@ type PrefixConfig struct {
 // ValidationErrors is a type alias for the validator.ValidationErrors
 type ValidationErrors = validator.ValidationErrors
 
+// RFC8106Warnings returns a human-readable warning for every RDNSS/DNSSL
+// entry whose LifetimeSeconds exceeds 2 * RAIntervalMilliseconds/1000, the
+// upper bound recommended by RFC8106 section 5.1 so that a recursive DNS
+// server or search domain isn't relied upon past the point where a fresh
+// RA would have refreshed it. This is a recommendation, not a MUST, so
+// defaultAndValidate doesn't reject it outright.
+func (i *InterfaceConfig) RFC8106Warnings() []string {
+	maxLifetimeSeconds := 2 * i.RAIntervalMilliseconds / 1000
+
+	var warnings []string
+	for _, r := range i.RDNSS {
+		if r.LifetimeSeconds != nil && *r.LifetimeSeconds > maxLifetimeSeconds {
+			warnings = append(warnings, fmt.Sprintf("rdnss %v: lifetimeSeconds %d exceeds the RFC8106 section 5.1 recommended maximum of %d (2 * raIntervalMilliseconds/1000)", r.Addresses, *r.LifetimeSeconds, maxLifetimeSeconds))
+		}
+	}
+
+	for _, d := range i.DNSSL {
+		if d.LifetimeSeconds != nil && *d.LifetimeSeconds > maxLifetimeSeconds {
+			warnings = append(warnings, fmt.Sprintf("dnssl %v: lifetimeSeconds %d exceeds the RFC8106 section 5.1 recommended maximum of %d (2 * raIntervalMilliseconds/1000)", d.DomainNames, *d.LifetimeSeconds, maxLifetimeSeconds))
+		}
+	}
+
+	return warnings
+}
+
 func (c *Config) defaultAndValidate() error {
 	if err := defaults.Set(c); err != nil {
 		panic("BUG (Please report 🙏): Defaulting failed: " + err.Error())
@@ -174,6 +333,85 @@ func (c *Config) defaultAndValidate() error {
 		return true
 	})
 
+	validate.RegisterValidation("non_nil_and_non_overlapping_route", func(fl validator.FieldLevel) bool {
+		prefixes := []netip.Prefix{}
+
+		routeSlice := fl.Field()
+		for i := 0; i < routeSlice.Len(); i++ {
+			routeElemp := routeSlice.Index(i)
+			if routeElemp.IsNil() {
+				return false
+			}
+
+			routeElem := routeElemp.Elem()
+			prefix := routeElem.FieldByName("Prefix")
+
+			p, err := netip.ParsePrefix(prefix.String())
+			if err != nil {
+				// Just ignore this error here. cidrv6 constraint will catch it later.
+				continue
+			}
+
+			prefixes = append(prefixes, p)
+		}
+
+		// Check the prefix is not overlapping with each other
+		for _, p0 := range prefixes {
+			for _, p1 := range prefixes {
+				if p0 != p1 && p0.Overlaps(p1) {
+					return false
+				}
+			}
+		}
+
+		return true
+	})
+
+	// Adhoc custom validator which validates that a DNS search domain
+	// name respects the RFC1035 label (<= 63 octets) and overall name
+	// (<= 255 octets) length limits.
+	validate.RegisterValidation("valid_dns_search_domain", func(fl validator.FieldLevel) bool {
+		name := fl.Field().String()
+		if len(name) > 255 {
+			return false
+		}
+
+		for _, label := range strings.Split(strings.TrimSuffix(name, "."), ".") {
+			if len(label) == 0 || len(label) > 63 {
+				return false
+			}
+		}
+
+		return true
+	})
+
+	// Adhoc custom validator which forbids configuring CipherSuites
+	// alongside MinVersion 1.3, since TLS 1.3 cipher suites are not
+	// user-configurable in crypto/tls.
+	validate.RegisterValidation("no_cipher_suites_for_tls13", func(fl validator.FieldLevel) bool {
+		minVersion := fl.Parent().FieldByName("MinVersion").String()
+		return !(minVersion == "1.3" && fl.Field().Len() > 0)
+	})
+
+	// Adhoc custom validator which validates that a cipher suite name is
+	// known to crypto/tls, covering both the default-enabled and the
+	// explicitly insecure suites so operators can still opt into a legacy
+	// suite if their fleet requires it.
+	validate.RegisterValidation("valid_tls_cipher_suite_name", func(fl validator.FieldLevel) bool {
+		name := fl.Field().String()
+		for _, suite := range tls.CipherSuites() {
+			if suite.Name == name {
+				return true
+			}
+		}
+		for _, suite := range tls.InsecureCipherSuites() {
+			if suite.Name == name {
+				return true
+			}
+		}
+		return false
+	})
+
 	if err := validate.Struct(c); err != nil {
 		if _, ok := err.(*validator.InvalidValidationError); ok {
 			panic("BUG (Please report 🙏): Invalid validation: " + err.Error())
@@ -229,3 +467,61 @@ func ParseConfigYAMLFile(path string) (*Config, error) {
 	defer f.Close()
 	return ParseConfigYAML(f)
 }
+
+// ParseConfigYAMLDir reads every *.yaml/*.yml file directly under path, in
+// lexicographic order, and merges them into a single Config: later files
+// override earlier interface entries by Name, and append any new ones.
+// This lets operators drop per-interface snippets under a directory such
+// as /etc/go-ra/conf.d/, the same way nginx/consul/coredns do.
+//
+// An interface entry is replaced whole, so prefixes are never combined
+// across files. This function doesn't validate the merged configuration:
+// overlapping prefixes within an interface entry are only caught by the
+// non_nil_and_non_overlapping_prefix validation when you pass the result
+// to the Daemon.
+func ParseConfigYAMLDir(path string) (*Config, error) {
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, e := range entries {
+		if e.IsDir() {
+			continue
+		}
+		switch filepath.Ext(e.Name()) {
+		case ".yaml", ".yml":
+			names = append(names, e.Name())
+		}
+	}
+	sort.Strings(names)
+
+	merged := &Config{}
+	indexByName := map[string]int{}
+
+	for _, name := range names {
+		c, err := ParseConfigYAMLFile(filepath.Join(path, name))
+		if err != nil {
+			return nil, fmt.Errorf("parsing %s: %w", name, err)
+		}
+
+		for _, iface := range c.Interfaces {
+			if idx, ok := indexByName[iface.Name]; ok {
+				merged.Interfaces[idx] = iface
+			} else {
+				indexByName[iface.Name] = len(merged.Interfaces)
+				merged.Interfaces = append(merged.Interfaces, iface)
+			}
+		}
+
+		if c.Management != nil {
+			merged.Management = c.Management
+		}
+		if c.Logging != nil {
+			merged.Logging = c.Logging
+		}
+	}
+
+	return merged, nil
+}
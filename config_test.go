@@ -0,0 +1,189 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of go-ra
+
+package ra
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigManagementTLSValidation(t *testing.T) {
+	base := func() *Config {
+		return &Config{
+			Interfaces: []*InterfaceConfig{{Name: "net0"}},
+			Management: &ManagementConfig{
+				TLS: &TLSConfig{
+					CertFile: "/etc/go-ra/tls.crt",
+					KeyFile:  "/etc/go-ra/tls.key",
+				},
+			},
+		}
+	}
+
+	t.Run("defaults MinVersion to 1.2", func(t *testing.T) {
+		c := base()
+		require.NoError(t, c.defaultAndValidate())
+		assert.Equal(t, "1.2", c.Management.TLS.MinVersion)
+	})
+
+	t.Run("rejects MinVersion below 1.2", func(t *testing.T) {
+		c := base()
+		c.Management.TLS.MinVersion = "1.1"
+		assert.Error(t, c.defaultAndValidate())
+	})
+
+	t.Run("rejects unknown cipher suite names", func(t *testing.T) {
+		c := base()
+		c.Management.TLS.CipherSuites = []string{"NOT_A_REAL_SUITE"}
+		assert.Error(t, c.defaultAndValidate())
+	})
+
+	t.Run("accepts known cipher suite names on TLS 1.2", func(t *testing.T) {
+		c := base()
+		c.Management.TLS.CipherSuites = []string{"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256"}
+		assert.NoError(t, c.defaultAndValidate())
+	})
+
+	t.Run("rejects cipher suites configured alongside TLS 1.3", func(t *testing.T) {
+		c := base()
+		c.Management.TLS.MinVersion = "1.3"
+		c.Management.TLS.CipherSuites = []string{"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256"}
+		assert.Error(t, c.defaultAndValidate())
+	})
+}
+
+func TestConfigRDNSSDNSSLRouteValidation(t *testing.T) {
+	base := func() *Config {
+		return &Config{
+			Interfaces: []*InterfaceConfig{
+				{
+					Name: "net0",
+					RDNSS: []*RDNSSConfig{
+						{Addresses: []string{"2001:db8::1"}},
+					},
+					DNSSL: []*DNSSLConfig{
+						{DomainNames: []string{"example.com"}},
+					},
+					Routes: []*RouteConfig{
+						{Prefix: "2001:db8::/32"},
+					},
+				},
+			},
+		}
+	}
+
+	t.Run("accepts well-formed entries", func(t *testing.T) {
+		c := base()
+		require.NoError(t, c.defaultAndValidate())
+		assert.Equal(t, "medium", c.Interfaces[0].Routes[0].Preference)
+	})
+
+	t.Run("rejects non-IPv6 RDNSS addresses", func(t *testing.T) {
+		c := base()
+		c.Interfaces[0].RDNSS[0].Addresses = []string{"not-an-address"}
+		assert.Error(t, c.defaultAndValidate())
+	})
+
+	t.Run("rejects DNSSL labels longer than 63 octets", func(t *testing.T) {
+		c := base()
+		c.Interfaces[0].DNSSL[0].DomainNames = []string{strings.Repeat("a", 64) + ".example.com"}
+		assert.Error(t, c.defaultAndValidate())
+	})
+
+	t.Run("rejects overlapping routes", func(t *testing.T) {
+		c := base()
+		c.Interfaces[0].Routes = append(c.Interfaces[0].Routes, &RouteConfig{Prefix: "2001:db8::/64"})
+		assert.Error(t, c.defaultAndValidate())
+	})
+
+	t.Run("rejects unknown route preference", func(t *testing.T) {
+		c := base()
+		c.Interfaces[0].Routes[0].Preference = "urgent"
+		assert.Error(t, c.defaultAndValidate())
+	})
+}
+
+func TestConfigLoggingValidation(t *testing.T) {
+	base := func() *Config {
+		return &Config{
+			Interfaces: []*InterfaceConfig{{Name: "net0"}},
+			Logging:    &LoggingConfig{},
+		}
+	}
+
+	t.Run("defaults to text/info", func(t *testing.T) {
+		c := base()
+		require.NoError(t, c.defaultAndValidate())
+		assert.Equal(t, "text", c.Logging.Format)
+		assert.Equal(t, "info", c.Logging.Level)
+	})
+
+	t.Run("rejects unknown format", func(t *testing.T) {
+		c := base()
+		c.Logging.Format = "xml"
+		assert.Error(t, c.defaultAndValidate())
+	})
+
+	t.Run("defaults syslog facility and tag", func(t *testing.T) {
+		c := base()
+		c.Logging.Syslog = &SyslogConfig{}
+		require.NoError(t, c.defaultAndValidate())
+		assert.Equal(t, "daemon", c.Logging.Syslog.Facility)
+		assert.Equal(t, "go-ra", c.Logging.Syslog.Tag)
+	})
+}
+
+func TestParseConfigYAMLDirMergesByInterfaceName(t *testing.T) {
+	dir := t.TempDir()
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "00-net0.yaml"), []byte(`
+interfaces:
+  - name: net0
+    raIntervalMilliseconds: 100
+  - name: net1
+    raIntervalMilliseconds: 100
+`), 0o644))
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "10-net0-override.yaml"), []byte(`
+interfaces:
+  - name: net0
+    raIntervalMilliseconds: 200
+  - name: net2
+    raIntervalMilliseconds: 300
+`), 0o644))
+
+	// Ignored: not a YAML file.
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "README.md"), []byte("not yaml"), 0o644))
+
+	c, err := ParseConfigYAMLDir(dir)
+	require.NoError(t, err)
+	require.Len(t, c.Interfaces, 3)
+
+	byName := map[string]*InterfaceConfig{}
+	for _, iface := range c.Interfaces {
+		byName[iface.Name] = iface
+	}
+
+	assert.Equal(t, 200, byName["net0"].RAIntervalMilliseconds)
+	assert.Equal(t, 100, byName["net1"].RAIntervalMilliseconds)
+	assert.Equal(t, 300, byName["net2"].RAIntervalMilliseconds)
+}
+
+func TestInterfaceConfigRFC8106Warnings(t *testing.T) {
+	lifetime := 10000
+	iface := &InterfaceConfig{
+		RAIntervalMilliseconds: 600000, // 2 * 600 = 1200s max
+		RDNSS: []*RDNSSConfig{
+			{Addresses: []string{"2001:db8::1"}, LifetimeSeconds: &lifetime},
+		},
+	}
+
+	warnings := iface.RFC8106Warnings()
+	require.Len(t, warnings, 1)
+}
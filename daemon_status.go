@@ -0,0 +1,11 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of go-ra
+
+package ra
+
+// Config returns the configuration d is currently running with, i.e. the
+// one passed to New or the most recently applied one via Reload. Callers
+// must not mutate the returned value.
+func (d *Daemon) Config() *Config {
+	return d.config
+}
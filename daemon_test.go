@@ -1,4 +1,4 @@
-package radv
+package ra
 
 import (
 	"context"
@@ -0,0 +1,74 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of go-ra
+
+// Package log builds the *slog.Logger sinks used by the daemon, selectable
+// via Config.Logging: JSON stderr, text stderr, or syslog.
+package log
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+
+	ra "github.com/Enigamict/go-ra"
+)
+
+// New builds the *slog.Logger described by cfg. cfg is assumed to have
+// already passed Config.defaultAndValidate; a nil cfg yields the same
+// text-formatted, info-level stderr logger as a LoggingConfig left at its
+// defaults.
+func New(cfg *ra.LoggingConfig) (*slog.Logger, error) {
+	if cfg == nil {
+		cfg = &ra.LoggingConfig{Format: "text", Level: "info"}
+	}
+
+	level, err := parseLevel(cfg.Level)
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.Syslog != nil {
+		handler, err := newSyslogHandler(cfg.Syslog, level)
+		if err != nil {
+			return nil, err
+		}
+		return slog.New(handler), nil
+	}
+
+	opts := &slog.HandlerOptions{Level: level}
+	switch cfg.Format {
+	case "json":
+		return slog.New(slog.NewJSONHandler(os.Stderr, opts)), nil
+	case "text":
+		return slog.New(slog.NewTextHandler(os.Stderr, opts)), nil
+	default:
+		return nil, fmt.Errorf("unknown logging format %q", cfg.Format)
+	}
+}
+
+func parseLevel(level string) (slog.Level, error) {
+	switch level {
+	case "debug":
+		return slog.LevelDebug, nil
+	case "info":
+		return slog.LevelInfo, nil
+	case "warn":
+		return slog.LevelWarn, nil
+	case "error":
+		return slog.LevelError, nil
+	default:
+		return 0, fmt.Errorf("unknown logging level %q", level)
+	}
+}
+
+// ForInterface returns logger with an "interface" attribute set, so that
+// operators can filter per-link RA send/receive events.
+func ForInterface(logger *slog.Logger, iface string) *slog.Logger {
+	return logger.With(slog.String("interface", iface))
+}
+
+// ForPrefix returns logger with a "prefix" attribute set, in addition to
+// whatever attributes it already carries.
+func ForPrefix(logger *slog.Logger, prefix string) *slog.Logger {
+	return logger.With(slog.String("prefix", prefix))
+}
@@ -0,0 +1,35 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of go-ra
+
+package log
+
+import (
+	"bytes"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	ra "github.com/Enigamict/go-ra"
+)
+
+func TestNewRejectsUnknownFormatAndLevel(t *testing.T) {
+	_, err := New(&ra.LoggingConfig{Format: "xml", Level: "info"})
+	assert.Error(t, err)
+
+	_, err = New(&ra.LoggingConfig{Format: "text", Level: "verbose"})
+	assert.Error(t, err)
+}
+
+func TestForInterfaceAndForPrefixAddAttributes(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+
+	logger = ForInterface(logger, "net0")
+	logger = ForPrefix(logger, "2001:db8::/64")
+	logger.Info("sent RA")
+
+	require.Contains(t, buf.String(), `"interface":"net0"`)
+	require.Contains(t, buf.String(), `"prefix":"2001:db8::/64"`)
+}
@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of go-ra
+
+//go:build !windows
+
+package log
+
+import (
+	"log/slog"
+	"log/syslog"
+
+	ra "github.com/Enigamict/go-ra"
+)
+
+var syslogFacilities = map[string]syslog.Priority{
+	"kern":     syslog.LOG_KERN,
+	"user":     syslog.LOG_USER,
+	"mail":     syslog.LOG_MAIL,
+	"daemon":   syslog.LOG_DAEMON,
+	"auth":     syslog.LOG_AUTH,
+	"syslog":   syslog.LOG_SYSLOG,
+	"lpr":      syslog.LOG_LPR,
+	"news":     syslog.LOG_NEWS,
+	"uucp":     syslog.LOG_UUCP,
+	"cron":     syslog.LOG_CRON,
+	"authpriv": syslog.LOG_AUTHPRIV,
+	"ftp":      syslog.LOG_FTP,
+	"local0":   syslog.LOG_LOCAL0,
+	"local1":   syslog.LOG_LOCAL1,
+	"local2":   syslog.LOG_LOCAL2,
+	"local3":   syslog.LOG_LOCAL3,
+	"local4":   syslog.LOG_LOCAL4,
+	"local5":   syslog.LOG_LOCAL5,
+	"local6":   syslog.LOG_LOCAL6,
+	"local7":   syslog.LOG_LOCAL7,
+}
+
+func newSyslogHandler(cfg *ra.SyslogConfig, level slog.Level) (slog.Handler, error) {
+	priority := syslogFacilities[cfg.Facility] | syslog.LOG_INFO
+
+	w, err := syslog.Dial(cfg.Network, cfg.Address, priority, cfg.Tag)
+	if err != nil {
+		return nil, err
+	}
+
+	return slog.NewTextHandler(w, &slog.HandlerOptions{Level: level}), nil
+}
@@ -0,0 +1,17 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of go-ra
+
+//go:build windows
+
+package log
+
+import (
+	"errors"
+	"log/slog"
+
+	ra "github.com/Enigamict/go-ra"
+)
+
+func newSyslogHandler(cfg *ra.SyslogConfig, level slog.Level) (slog.Handler, error) {
+	return nil, errors.New("log: syslog sink is not supported on windows")
+}
@@ -0,0 +1,160 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of go-ra
+
+// Package metrics defines the Prometheus collectors exposed by the daemon,
+// keyed by interface and, where applicable, prefix.
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Metrics holds every Prometheus collector registered by the daemon.
+type Metrics struct {
+	raSent         *prometheus.CounterVec
+	raSolicited    *prometheus.CounterVec
+	rsReceived     *prometheus.CounterVec
+	raSendErrors   *prometheus.CounterVec
+	reloadTotal    *prometheus.CounterVec
+	raInterval     *prometheus.GaugeVec
+	routerLifetime *prometheus.GaugeVec
+	prefixValid    *prometheus.GaugeVec
+	prefixPref     *prometheus.GaugeVec
+	configuredPfx  *prometheus.GaugeVec
+	sendJitter     *prometheus.HistogramVec
+}
+
+// New creates the daemon's Prometheus collectors and registers them with
+// reg.
+func New(reg prometheus.Registerer) (*Metrics, error) {
+	m := &Metrics{
+		raSent: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "ra_sent_total",
+			Help: "Total number of Router Advertisements sent.",
+		}, []string{"interface"}),
+		raSolicited: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "ra_solicited_total",
+			Help: "Total number of solicited Router Advertisements sent.",
+		}, []string{"interface"}),
+		rsReceived: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "rs_received_total",
+			Help: "Total number of Router Solicitations received.",
+		}, []string{"interface"}),
+		raSendErrors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "ra_send_errors_total",
+			Help: "Total number of errors encountered while sending a Router Advertisement.",
+		}, []string{"interface"}),
+		reloadTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "reload_total",
+			Help: "Total number of configuration reloads, by result.",
+		}, []string{"result"}),
+		raInterval: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "ra_interval_seconds",
+			Help: "Configured interval between unsolicited Router Advertisements.",
+		}, []string{"interface"}),
+		routerLifetime: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "router_lifetime_seconds",
+			Help: "Configured default router lifetime.",
+		}, []string{"interface"}),
+		prefixValid: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "prefix_valid_lifetime_seconds",
+			Help: "Configured valid lifetime of an advertised prefix.",
+		}, []string{"interface", "prefix"}),
+		prefixPref: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "prefix_preferred_lifetime_seconds",
+			Help: "Configured preferred lifetime of an advertised prefix.",
+		}, []string{"interface", "prefix"}),
+		configuredPfx: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "configured_prefixes",
+			Help: "Number of prefixes configured on an interface.",
+		}, []string{"interface"}),
+		sendJitter: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "ra_send_jitter_seconds",
+			Help:    "Difference between the actual and the configured Router Advertisement send interval.",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"interface"}),
+	}
+
+	collectors := []prometheus.Collector{
+		m.raSent, m.raSolicited, m.rsReceived, m.raSendErrors, m.reloadTotal,
+		m.raInterval, m.routerLifetime, m.prefixValid, m.prefixPref,
+		m.configuredPfx, m.sendJitter,
+	}
+	for _, c := range collectors {
+		if err := reg.Register(c); err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
+
+// RASent records an unsolicited Router Advertisement sent on iface.
+func (m *Metrics) RASent(iface string) {
+	m.raSent.WithLabelValues(iface).Inc()
+}
+
+// RASolicited records a solicited Router Advertisement sent on iface.
+func (m *Metrics) RASolicited(iface string) {
+	m.raSolicited.WithLabelValues(iface).Inc()
+}
+
+// RSReceived records a Router Solicitation received on iface.
+func (m *Metrics) RSReceived(iface string) {
+	m.rsReceived.WithLabelValues(iface).Inc()
+}
+
+// RASendError records a failure to send a Router Advertisement on iface.
+func (m *Metrics) RASendError(iface string) {
+	m.raSendErrors.WithLabelValues(iface).Inc()
+}
+
+// Reload records the result ("ok" or "error") of a configuration reload.
+func (m *Metrics) Reload(result string) {
+	m.reloadTotal.WithLabelValues(result).Inc()
+}
+
+// SendJitter records the difference between the actual and the configured
+// send interval for an unsolicited Router Advertisement on iface.
+func (m *Metrics) SendJitter(iface string, actual, configured time.Duration) {
+	m.sendJitter.WithLabelValues(iface).Observe((actual - configured).Seconds())
+}
+
+// SetInterfaceGauges updates the per-interface gauges to match the
+// currently applied configuration.
+func (m *Metrics) SetInterfaceGauges(iface string, raIntervalSeconds, routerLifetimeSeconds float64, configuredPrefixes int) {
+	m.raInterval.WithLabelValues(iface).Set(raIntervalSeconds)
+	m.routerLifetime.WithLabelValues(iface).Set(routerLifetimeSeconds)
+	m.configuredPfx.WithLabelValues(iface).Set(float64(configuredPrefixes))
+}
+
+// SetPrefixGauges updates the per-prefix gauges to match the currently
+// applied configuration.
+func (m *Metrics) SetPrefixGauges(iface, prefix string, validLifetimeSeconds, preferredLifetimeSeconds float64) {
+	m.prefixValid.WithLabelValues(iface, prefix).Set(validLifetimeSeconds)
+	m.prefixPref.WithLabelValues(iface, prefix).Set(preferredLifetimeSeconds)
+}
+
+// DeleteInterface removes every label set scoped to iface, e.g. because the
+// interface was dropped from the configuration on reload.
+func (m *Metrics) DeleteInterface(iface string) {
+	m.raSent.DeleteLabelValues(iface)
+	m.raSolicited.DeleteLabelValues(iface)
+	m.rsReceived.DeleteLabelValues(iface)
+	m.raSendErrors.DeleteLabelValues(iface)
+	m.raInterval.DeleteLabelValues(iface)
+	m.routerLifetime.DeleteLabelValues(iface)
+	m.configuredPfx.DeleteLabelValues(iface)
+	m.sendJitter.DeleteLabelValues(iface)
+	m.prefixValid.DeletePartialMatch(prometheus.Labels{"interface": iface})
+	m.prefixPref.DeletePartialMatch(prometheus.Labels{"interface": iface})
+}
+
+// DeletePrefix removes the label set for a single prefix that was dropped
+// from iface's configuration on reload.
+func (m *Metrics) DeletePrefix(iface, prefix string) {
+	m.prefixValid.DeleteLabelValues(iface, prefix)
+	m.prefixPref.DeleteLabelValues(iface, prefix)
+}
@@ -0,0 +1,29 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of go-ra
+
+package metrics
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetricsDeleteInterfaceRemovesStaleLabels(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	m, err := New(reg)
+	require.NoError(t, err)
+
+	m.RASent("net0")
+	m.SetInterfaceGauges("net0", 600, 1800, 1)
+	m.SetPrefixGauges("net0", "2001:db8::/64", 2592000, 604800)
+
+	require.Equal(t, float64(1), testutil.ToFloat64(m.raSent.WithLabelValues("net0")))
+
+	m.DeleteInterface("net0")
+
+	require.Equal(t, float64(0), testutil.ToFloat64(m.raSent.WithLabelValues("net0")))
+	require.Equal(t, float64(0), testutil.ToFloat64(m.prefixValid.WithLabelValues("net0", "2001:db8::/64")))
+}
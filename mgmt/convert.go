@@ -0,0 +1,157 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of go-ra
+
+package mgmt
+
+import (
+	ra "github.com/Enigamict/go-ra"
+	"github.com/Enigamict/go-ra/mgmt/mgmtpb"
+)
+
+func fromPBConfig(pb *mgmtpb.Config) *ra.Config {
+	if pb == nil {
+		return &ra.Config{}
+	}
+
+	c := &ra.Config{
+		Interfaces: make([]*ra.InterfaceConfig, 0, len(pb.GetInterfaces())),
+	}
+	for _, iface := range pb.GetInterfaces() {
+		c.Interfaces = append(c.Interfaces, fromPBInterfaceConfig(iface))
+	}
+	return c
+}
+
+func fromPBInterfaceConfig(pb *mgmtpb.InterfaceConfig) *ra.InterfaceConfig {
+	if pb == nil {
+		return nil
+	}
+
+	iface := &ra.InterfaceConfig{
+		Name:                       pb.GetName(),
+		RAIntervalMilliseconds:     int(pb.RaIntervalMilliseconds),
+		CurrentHopLimit:            int(pb.CurrentHopLimit),
+		Managed:                    pb.Managed,
+		Other:                      pb.Other,
+		RouterLifetimeSeconds:      int(pb.RouterLifetimeSeconds),
+		ReachableTimeMilliseconds:  int(pb.ReachableTimeMilliseconds),
+		RetransmitTimeMilliseconds: int(pb.RetransmitTimeMilliseconds),
+		Prefixes:                   make([]*ra.PrefixConfig, 0, len(pb.GetPrefixes())),
+		RDNSS:                      make([]*ra.RDNSSConfig, 0, len(pb.GetRdnss())),
+		DNSSL:                      make([]*ra.DNSSLConfig, 0, len(pb.GetDnssl())),
+		Routes:                     make([]*ra.RouteConfig, 0, len(pb.GetRoutes())),
+	}
+
+	for _, p := range pb.GetPrefixes() {
+		valid := int(p.ValidLifetimeSeconds)
+		preferred := int(p.PreferredLifetimeSeconds)
+		iface.Prefixes = append(iface.Prefixes, &ra.PrefixConfig{
+			Prefix:                   p.Prefix,
+			OnLink:                   p.OnLink,
+			Autonomous:               p.Autonomous,
+			ValidLifetimeSeconds:     &valid,
+			PreferredLifetimeSeconds: &preferred,
+		})
+	}
+
+	for _, r := range pb.GetRdnss() {
+		lifetime := int(r.LifetimeSeconds)
+		iface.RDNSS = append(iface.RDNSS, &ra.RDNSSConfig{
+			Addresses:       r.GetAddresses(),
+			LifetimeSeconds: &lifetime,
+		})
+	}
+
+	for _, d := range pb.GetDnssl() {
+		lifetime := int(d.LifetimeSeconds)
+		iface.DNSSL = append(iface.DNSSL, &ra.DNSSLConfig{
+			DomainNames:     d.GetDomainNames(),
+			LifetimeSeconds: &lifetime,
+		})
+	}
+
+	for _, r := range pb.GetRoutes() {
+		lifetime := int(r.LifetimeSeconds)
+		iface.Routes = append(iface.Routes, &ra.RouteConfig{
+			Prefix:          r.Prefix,
+			Preference:      r.Preference,
+			LifetimeSeconds: &lifetime,
+		})
+	}
+
+	return iface
+}
+
+func toPBInterfaceConfig(iface *ra.InterfaceConfig) *mgmtpb.InterfaceConfig {
+	if iface == nil {
+		return nil
+	}
+
+	pb := &mgmtpb.InterfaceConfig{
+		Name:                       iface.Name,
+		RaIntervalMilliseconds:     int32(iface.RAIntervalMilliseconds),
+		CurrentHopLimit:            int32(iface.CurrentHopLimit),
+		Managed:                    iface.Managed,
+		Other:                      iface.Other,
+		RouterLifetimeSeconds:      int32(iface.RouterLifetimeSeconds),
+		ReachableTimeMilliseconds:  int64(iface.ReachableTimeMilliseconds),
+		RetransmitTimeMilliseconds: int64(iface.RetransmitTimeMilliseconds),
+		Prefixes:                   make([]*mgmtpb.PrefixConfig, 0, len(iface.Prefixes)),
+		Rdnss:                      make([]*mgmtpb.RDNSSConfig, 0, len(iface.RDNSS)),
+		Dnssl:                      make([]*mgmtpb.DNSSLConfig, 0, len(iface.DNSSL)),
+		Routes:                     make([]*mgmtpb.RouteConfig, 0, len(iface.Routes)),
+	}
+
+	for _, p := range iface.Prefixes {
+		pbp := &mgmtpb.PrefixConfig{
+			Prefix:     p.Prefix,
+			OnLink:     p.OnLink,
+			Autonomous: p.Autonomous,
+		}
+		if p.ValidLifetimeSeconds != nil {
+			pbp.ValidLifetimeSeconds = int64(*p.ValidLifetimeSeconds)
+		}
+		if p.PreferredLifetimeSeconds != nil {
+			pbp.PreferredLifetimeSeconds = int64(*p.PreferredLifetimeSeconds)
+		}
+		pb.Prefixes = append(pb.Prefixes, pbp)
+	}
+
+	for _, r := range iface.RDNSS {
+		pbr := &mgmtpb.RDNSSConfig{Addresses: r.Addresses}
+		if r.LifetimeSeconds != nil {
+			pbr.LifetimeSeconds = int64(*r.LifetimeSeconds)
+		}
+		pb.Rdnss = append(pb.Rdnss, pbr)
+	}
+
+	for _, d := range iface.DNSSL {
+		pbd := &mgmtpb.DNSSLConfig{DomainNames: d.DomainNames}
+		if d.LifetimeSeconds != nil {
+			pbd.LifetimeSeconds = int64(*d.LifetimeSeconds)
+		}
+		pb.Dnssl = append(pb.Dnssl, pbd)
+	}
+
+	for _, r := range iface.Routes {
+		pbr := &mgmtpb.RouteConfig{Prefix: r.Prefix, Preference: r.Preference}
+		if r.LifetimeSeconds != nil {
+			pbr.LifetimeSeconds = int64(*r.LifetimeSeconds)
+		}
+		pb.Routes = append(pb.Routes, pbr)
+	}
+
+	return pb
+}
+
+func toPBValidationErrors(verrs ra.ValidationErrors) []*mgmtpb.ValidationError {
+	out := make([]*mgmtpb.ValidationError, 0, len(verrs))
+	for _, fe := range verrs {
+		out = append(out, &mgmtpb.ValidationError{
+			Field:   fe.Namespace(),
+			Tag:     fe.Tag(),
+			Message: fe.Error(),
+		})
+	}
+	return out
+}
@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of go-ra
+
+package mgmt
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/require"
+
+	ra "github.com/Enigamict/go-ra"
+)
+
+func TestServerExposesMetricsOverHTTP(t *testing.T) {
+	grpcLis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	httpLis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(prometheus.NewCounter(prometheus.CounterOpts{Name: "probe_metric_total"}))
+
+	daemon, err := ra.New(&ra.Config{})
+	require.NoError(t, err)
+
+	s, err := New(daemon, WithGRPCListener(grpcLis), WithHTTPListener(httpLis), WithMetricsRegisterer(reg))
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	go s.Serve(ctx)
+
+	resp, err := http.Get(fmt.Sprintf("http://%s/metrics", httpLis.Addr().String()))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.Contains(t, string(body), "probe_metric_total")
+}
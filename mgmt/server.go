@@ -0,0 +1,311 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of go-ra
+
+// Package mgmt implements a gRPC management API that lets operators inspect
+// and reconfigure a running ra.Daemon without restarting the process. It
+// also registers the standard grpc.health.v1.Health service so that
+// orchestrators can probe the daemon's readiness.
+package mgmt
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+
+	ra "github.com/Enigamict/go-ra"
+	"github.com/Enigamict/go-ra/mgmt/mgmtpb"
+)
+
+// serviceName is the health-checked service name reported via
+// grpc.health.v1.Health.
+const serviceName = "mgmt.RADaemon"
+
+type options struct {
+	listener     net.Listener
+	httpListener net.Listener
+	management   *ra.ManagementConfig
+	registerer   prometheus.Registerer
+}
+
+// Option configures a Server returned by New.
+type Option func(*options)
+
+// WithGRPCListener makes the Server serve on lis instead of a real network
+// socket. This mirrors the socket-constructor injection used to unit-test
+// the RA send loop: tests can pass an in-memory listener (e.g.
+// bufconn.Listen) so the gRPC API is exercisable without binding a port.
+func WithGRPCListener(lis net.Listener) Option {
+	return func(o *options) {
+		o.listener = lis
+	}
+}
+
+// WithManagementConfig configures the Server from m, e.g. enabling TLS
+// (and optionally mTLS) when m.TLS is set. m is assumed to have already
+// passed Config.defaultAndValidate.
+func WithManagementConfig(m *ra.ManagementConfig) Option {
+	return func(o *options) {
+		o.management = m
+	}
+}
+
+// WithHTTPListener makes the Server's HTTP mux (currently just /metrics)
+// serve on lis instead of a real network socket.
+func WithHTTPListener(lis net.Listener) Option {
+	return func(o *options) {
+		o.httpListener = lis
+	}
+}
+
+// WithMetricsRegisterer exposes reg's collectors on the Server's HTTP mux
+// under /metrics. The HTTP mux is only started when this option is given.
+func WithMetricsRegisterer(reg prometheus.Registerer) Option {
+	return func(o *options) {
+		o.registerer = reg
+	}
+}
+
+// Server is the gRPC management API for a single ra.Daemon.
+type Server struct {
+	mgmtpb.UnimplementedRADaemonServer
+
+	daemon       *ra.Daemon
+	listener     net.Listener
+	grpc         *grpc.Server
+	health       *health.Server
+	httpListener net.Listener
+	http         *http.Server
+
+	mu           sync.RWMutex
+	cur          *ra.Config
+	reloading    bool
+	shuttingDown bool
+}
+
+// New creates a Server that drives daemon. If no listener is injected via
+// WithGRPCListener, it binds to 127.0.0.1 on a random port.
+func New(daemon *ra.Daemon, opts ...Option) (*Server, error) {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	if o.listener == nil {
+		lis, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			return nil, err
+		}
+		o.listener = lis
+	}
+
+	hs := health.NewServer()
+	hs.SetServingStatus(serviceName, healthpb.HealthCheckResponse_SERVING)
+	// Also serve the overall ("") status so that orchestrators probing with
+	// their default service name (e.g. Kubernetes' built-in gRPC probe and
+	// grpc_health_probe with no -service flag) see SERVING rather than
+	// NOT_FOUND.
+	hs.SetServingStatus("", healthpb.HealthCheckResponse_SERVING)
+
+	var serverOpts []grpc.ServerOption
+	if o.management != nil && o.management.TLS != nil {
+		tlsConfig, err := buildTLSConfig(o.management.TLS)
+		if err != nil {
+			return nil, err
+		}
+		serverOpts = append(serverOpts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	}
+
+	s := &Server{
+		daemon:   daemon,
+		listener: o.listener,
+		grpc:     grpc.NewServer(serverOpts...),
+		health:   hs,
+		cur:      daemon.Config(),
+	}
+
+	mgmtpb.RegisterRADaemonServer(s.grpc, s)
+	healthpb.RegisterHealthServer(s.grpc, hs)
+
+	if o.registerer != nil {
+		gatherer, ok := o.registerer.(prometheus.Gatherer)
+		if !ok {
+			return nil, errors.New("mgmt: registerer passed to WithMetricsRegisterer must also implement prometheus.Gatherer")
+		}
+
+		if o.httpListener == nil {
+			lis, err := net.Listen("tcp", "127.0.0.1:0")
+			if err != nil {
+				return nil, err
+			}
+			o.httpListener = lis
+		}
+
+		mux := http.NewServeMux()
+		mux.Handle("/metrics", promhttp.HandlerFor(gatherer, promhttp.HandlerOpts{}))
+
+		s.httpListener = o.httpListener
+		s.http = &http.Server{Handler: mux}
+	}
+
+	return s, nil
+}
+
+// Addr returns the address the Server's gRPC API is listening on.
+func (s *Server) Addr() net.Addr {
+	return s.listener.Addr()
+}
+
+// HTTPAddr returns the address the Server's HTTP mux is listening on, or
+// nil if WithMetricsRegisterer wasn't given.
+func (s *Server) HTTPAddr() net.Addr {
+	if s.httpListener == nil {
+		return nil
+	}
+	return s.httpListener.Addr()
+}
+
+// Serve runs the gRPC server until ctx is canceled. The health service is
+// marked NOT_SERVING as soon as ctx is done, and the server is stopped
+// gracefully.
+func (s *Server) Serve(ctx context.Context) error {
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- s.grpc.Serve(s.listener)
+	}()
+
+	if s.http != nil {
+		go func() {
+			if err := s.http.Serve(s.httpListener); err != nil && err != http.ErrServerClosed {
+				errCh <- err
+			}
+		}()
+	}
+
+	select {
+	case <-ctx.Done():
+		s.mu.Lock()
+		s.shuttingDown = true
+		s.mu.Unlock()
+		s.setServingStatus(healthpb.HealthCheckResponse_NOT_SERVING)
+		s.grpc.GracefulStop()
+		if s.http != nil {
+			_ = s.http.Shutdown(context.Background())
+		}
+		<-errCh
+		return ctx.Err()
+	case err := <-errCh:
+		return err
+	}
+}
+
+// GetStatus reports whether a reload is currently in flight and which
+// interfaces are in the last-applied configuration.
+func (s *Server) GetStatus(ctx context.Context, req *mgmtpb.GetStatusRequest) (*mgmtpb.GetStatusResponse, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return &mgmtpb.GetStatusResponse{
+		Reloading:  s.reloading,
+		Interfaces: interfaceNames(s.cur),
+	}, nil
+}
+
+// ReloadConfig validates and applies config, following the same
+// defaultAndValidate + Daemon.Reload path used by the YAML/JSON config
+// loaders. While the reload is in flight the health service reports
+// NOT_SERVING.
+func (s *Server) ReloadConfig(ctx context.Context, req *mgmtpb.ReloadConfigRequest) (*mgmtpb.ReloadConfigResponse, error) {
+	cfg := fromPBConfig(req.GetConfig())
+
+	s.mu.Lock()
+	s.reloading = true
+	s.mu.Unlock()
+	s.setServingStatus(healthpb.HealthCheckResponse_NOT_SERVING)
+
+	defer func() {
+		s.mu.Lock()
+		s.reloading = false
+		shuttingDown := s.shuttingDown
+		s.mu.Unlock()
+		// Don't resurrect SERVING once Serve has started shutting down:
+		// GracefulStop lets this RPC finish, and without this check its
+		// defer would contradict the NOT_SERVING status Serve already set
+		// for ctx cancellation.
+		if !shuttingDown {
+			s.setServingStatus(healthpb.HealthCheckResponse_SERVING)
+		}
+	}()
+
+	if err := s.daemon.Reload(ctx, cfg); err != nil {
+		var verrs ra.ValidationErrors
+		if errors.As(err, &verrs) {
+			return &mgmtpb.ReloadConfigResponse{ValidationErrors: toPBValidationErrors(verrs)}, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	s.mu.Lock()
+	s.cur = cfg
+	s.mu.Unlock()
+
+	return &mgmtpb.ReloadConfigResponse{}, nil
+}
+
+// ListInterfaces returns the names of the interfaces in the last-applied
+// configuration.
+func (s *Server) ListInterfaces(ctx context.Context, req *mgmtpb.ListInterfacesRequest) (*mgmtpb.ListInterfacesResponse, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return &mgmtpb.ListInterfacesResponse{Names: interfaceNames(s.cur)}, nil
+}
+
+// GetInterface returns the last-applied configuration for a single
+// interface.
+func (s *Server) GetInterface(ctx context.Context, req *mgmtpb.GetInterfaceRequest) (*mgmtpb.GetInterfaceResponse, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.cur == nil {
+		return nil, status.Errorf(codes.NotFound, "interface %q not found", req.GetName())
+	}
+
+	for _, iface := range s.cur.Interfaces {
+		if iface.Name == req.GetName() {
+			return &mgmtpb.GetInterfaceResponse{Interface: toPBInterfaceConfig(iface)}, nil
+		}
+	}
+
+	return nil, status.Errorf(codes.NotFound, "interface %q not found", req.GetName())
+}
+
+// setServingStatus updates both the per-service ("mgmt.RADaemon") and the
+// overall ("") health status, so that probes targeting either name observe
+// the same view of the server's health.
+func (s *Server) setServingStatus(st healthpb.HealthCheckResponse_ServingStatus) {
+	s.health.SetServingStatus(serviceName, st)
+	s.health.SetServingStatus("", st)
+}
+
+func interfaceNames(c *ra.Config) []string {
+	if c == nil {
+		return nil
+	}
+
+	names := make([]string, 0, len(c.Interfaces))
+	for _, iface := range c.Interfaces {
+		names = append(names, iface.Name)
+	}
+	return names
+}
@@ -0,0 +1,231 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of go-ra
+
+package mgmt
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	ra "github.com/Enigamict/go-ra"
+	"github.com/Enigamict/go-ra/mgmt/mgmtpb"
+)
+
+func TestServerReloadConfigAndStatus(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	daemon, err := ra.New(&ra.Config{})
+	require.NoError(t, err)
+
+	s, err := New(daemon, WithGRPCListener(lis))
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	go s.Serve(ctx)
+
+	conn, err := grpc.NewClient(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	client := mgmtpb.NewRADaemonClient(conn)
+	healthClient := healthpb.NewHealthClient(conn)
+
+	rctx, rcancel := context.WithTimeout(context.Background(), time.Second)
+	defer rcancel()
+
+	_, err = client.ReloadConfig(rctx, &mgmtpb.ReloadConfigRequest{
+		Config: &mgmtpb.Config{
+			Interfaces: []*mgmtpb.InterfaceConfig{
+				{Name: "net0", RaIntervalMilliseconds: 100},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	status, err := client.GetStatus(rctx, &mgmtpb.GetStatusRequest{})
+	require.NoError(t, err)
+	require.False(t, status.GetReloading())
+	require.Equal(t, []string{"net0"}, status.GetInterfaces())
+
+	hcResp, err := healthClient.Check(rctx, &healthpb.HealthCheckRequest{Service: serviceName})
+	require.NoError(t, err)
+	require.Equal(t, healthpb.HealthCheckResponse_SERVING, hcResp.GetStatus())
+
+	hcResp, err = healthClient.Check(rctx, &healthpb.HealthCheckRequest{})
+	require.NoError(t, err)
+	require.Equal(t, healthpb.HealthCheckResponse_SERVING, hcResp.GetStatus())
+}
+
+// TestServerReportsRunningConfigBeforeAnyReload ensures a server fronting
+// an already-configured daemon reports that daemon's interfaces from its
+// first request, without requiring an operator to push the config back
+// through ReloadConfig first.
+func TestServerReportsRunningConfigBeforeAnyReload(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	daemon, err := ra.New(&ra.Config{
+		Interfaces: []*ra.InterfaceConfig{
+			{Name: "net0", RAIntervalMilliseconds: 100},
+		},
+	})
+	require.NoError(t, err)
+
+	s, err := New(daemon, WithGRPCListener(lis))
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	go s.Serve(ctx)
+
+	conn, err := grpc.NewClient(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	client := mgmtpb.NewRADaemonClient(conn)
+
+	rctx, rcancel := context.WithTimeout(context.Background(), time.Second)
+	defer rcancel()
+
+	status, err := client.GetStatus(rctx, &mgmtpb.GetStatusRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []string{"net0"}, status.GetInterfaces())
+
+	listResp, err := client.ListInterfaces(rctx, &mgmtpb.ListInterfacesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []string{"net0"}, listResp.GetNames())
+
+	getResp, err := client.GetInterface(rctx, &mgmtpb.GetInterfaceRequest{Name: "net0"})
+	require.NoError(t, err)
+	require.Equal(t, "net0", getResp.GetInterface().GetName())
+}
+
+// TestServerReloadConfigDoesNotResurrectServingDuringShutdown guards
+// against a ReloadConfig that's still in flight when Serve's context is
+// canceled flipping health back to SERVING from its deferred cleanup,
+// which would contradict the NOT_SERVING status Serve already set.
+func TestServerReloadConfigDoesNotResurrectServingDuringShutdown(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	daemon, err := ra.New(&ra.Config{})
+	require.NoError(t, err)
+
+	s, err := New(daemon, WithGRPCListener(lis))
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	go s.Serve(ctx)
+
+	// Simulate Serve's ctx.Done() branch having already run, as would
+	// happen if it raced ahead of a ReloadConfig in flight.
+	s.mu.Lock()
+	s.shuttingDown = true
+	s.mu.Unlock()
+	s.setServingStatus(healthpb.HealthCheckResponse_NOT_SERVING)
+
+	rctx, rcancel := context.WithTimeout(context.Background(), time.Second)
+	defer rcancel()
+
+	_, err = s.ReloadConfig(rctx, &mgmtpb.ReloadConfigRequest{
+		Config: &mgmtpb.Config{
+			Interfaces: []*mgmtpb.InterfaceConfig{{Name: "net0", RaIntervalMilliseconds: 100}},
+		},
+	})
+	require.NoError(t, err)
+
+	hcResp, err := healthpb.NewHealthClient(mustDial(t, lis)).Check(rctx, &healthpb.HealthCheckRequest{})
+	require.NoError(t, err)
+	require.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, hcResp.GetStatus())
+}
+
+func mustDial(t *testing.T, lis net.Listener) *grpc.ClientConn {
+	t.Helper()
+	conn, err := grpc.NewClient(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+// TestServerRoundTripsRDNSSDNSSLRoutes guards against a read-modify-write
+// through ReloadConfig silently dropping an interface's RDNSS/DNSSL/Route
+// options, since GetInterface's result is exactly what a caller would feed
+// back into ReloadConfig.
+func TestServerRoundTripsRDNSSDNSSLRoutes(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	daemon, err := ra.New(&ra.Config{})
+	require.NoError(t, err)
+
+	s, err := New(daemon, WithGRPCListener(lis))
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	go s.Serve(ctx)
+
+	conn, err := grpc.NewClient(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	client := mgmtpb.NewRADaemonClient(conn)
+
+	rctx, rcancel := context.WithTimeout(context.Background(), time.Second)
+	defer rcancel()
+
+	_, err = client.ReloadConfig(rctx, &mgmtpb.ReloadConfigRequest{
+		Config: &mgmtpb.Config{
+			Interfaces: []*mgmtpb.InterfaceConfig{
+				{
+					Name:                   "net0",
+					RaIntervalMilliseconds: 100,
+					Rdnss: []*mgmtpb.RDNSSConfig{
+						{Addresses: []string{"2001:db8::1"}, LifetimeSeconds: 600},
+					},
+					Dnssl: []*mgmtpb.DNSSLConfig{
+						{DomainNames: []string{"example.com"}, LifetimeSeconds: 600},
+					},
+					Routes: []*mgmtpb.RouteConfig{
+						{Prefix: "2001:db8::/32", Preference: "high", LifetimeSeconds: 3600},
+					},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	getResp, err := client.GetInterface(rctx, &mgmtpb.GetInterfaceRequest{Name: "net0"})
+	require.NoError(t, err)
+	iface := getResp.GetInterface()
+	require.Len(t, iface.GetRdnss(), 1)
+	require.Equal(t, []string{"2001:db8::1"}, iface.GetRdnss()[0].GetAddresses())
+	require.Len(t, iface.GetDnssl(), 1)
+	require.Equal(t, []string{"example.com"}, iface.GetDnssl()[0].GetDomainNames())
+	require.Len(t, iface.GetRoutes(), 1)
+	require.Equal(t, "2001:db8::/32", iface.GetRoutes()[0].GetPrefix())
+
+	// Feed the read-back config straight into ReloadConfig: the options
+	// must not be dropped by the round-trip.
+	_, err = client.ReloadConfig(rctx, &mgmtpb.ReloadConfigRequest{
+		Config: &mgmtpb.Config{Interfaces: []*mgmtpb.InterfaceConfig{iface}},
+	})
+	require.NoError(t, err)
+
+	getResp, err = client.GetInterface(rctx, &mgmtpb.GetInterfaceRequest{Name: "net0"})
+	require.NoError(t, err)
+	iface = getResp.GetInterface()
+	require.Len(t, iface.GetRdnss(), 1)
+	require.Len(t, iface.GetDnssl(), 1)
+	require.Len(t, iface.GetRoutes(), 1)
+}
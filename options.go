@@ -0,0 +1,182 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of go-ra
+
+package ra
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net/netip"
+	"strings"
+)
+
+// ICMPv6 option types, as assigned by IANA.
+const (
+	icmpv6OptRouteInformation = 24 // RFC4191
+	icmpv6OptRDNSS            = 25 // RFC8106
+	icmpv6OptDNSSL            = 31 // RFC8106
+)
+
+// routePreference maps RouteConfig.Preference to the 2-bit Prf field
+// defined by RFC4191 section 2.1.
+var routePreference = map[string]byte{
+	"high":   0b01,
+	"medium": 0b00,
+	"low":    0b11,
+}
+
+// defaultLifetimeSeconds returns lifetimeSeconds, or the RFC8106 section
+// 5.1 recommended default of 2 * raIntervalMilliseconds/1000 when
+// lifetimeSeconds is nil.
+func defaultLifetimeSeconds(lifetimeSeconds *int, raIntervalMilliseconds int) int {
+	if lifetimeSeconds != nil {
+		return *lifetimeSeconds
+	}
+	return 2 * raIntervalMilliseconds / 1000
+}
+
+// EncodeRDNSSOption encodes c as an RFC8106 Recursive DNS Server ICMPv6
+// option.
+func EncodeRDNSSOption(c *RDNSSConfig, raIntervalMilliseconds int) ([]byte, error) {
+	addrs := make([]netip.Addr, 0, len(c.Addresses))
+	for _, a := range c.Addresses {
+		addr, err := netip.ParseAddr(a)
+		if err != nil {
+			return nil, fmt.Errorf("parsing RDNSS address %q: %w", a, err)
+		}
+		if !addr.Is6() || addr.Is4In6() {
+			return nil, fmt.Errorf("RDNSS address %q is not an IPv6 address", a)
+		}
+		addrs = append(addrs, addr)
+	}
+
+	lengthUnits := 1 + 2*len(addrs)
+	b := make([]byte, 8*lengthUnits)
+	b[0] = icmpv6OptRDNSS
+	b[1] = byte(lengthUnits)
+	// b[2:4] Reserved
+	binary.BigEndian.PutUint32(b[4:8], uint32(defaultLifetimeSeconds(c.LifetimeSeconds, raIntervalMilliseconds)))
+
+	for i, addr := range addrs {
+		raw := addr.As16()
+		copy(b[8+i*16:8+(i+1)*16], raw[:])
+	}
+
+	return b, nil
+}
+
+// EncodeDNSSLOption encodes c as an RFC8106 DNS Search List ICMPv6 option.
+func EncodeDNSSLOption(c *DNSSLConfig, raIntervalMilliseconds int) ([]byte, error) {
+	var encoded []byte
+	for _, name := range c.DomainNames {
+		enc, err := encodeDNSName(name)
+		if err != nil {
+			return nil, fmt.Errorf("encoding DNSSL domain name %q: %w", name, err)
+		}
+		encoded = append(encoded, enc...)
+	}
+
+	// Pad to an 8-octet boundary.
+	if rem := len(encoded) % 8; rem != 0 {
+		encoded = append(encoded, make([]byte, 8-rem)...)
+	}
+
+	lengthUnits := 1 + len(encoded)/8
+	b := make([]byte, 8*lengthUnits)
+	b[0] = icmpv6OptDNSSL
+	b[1] = byte(lengthUnits)
+	// b[2:4] Reserved
+	binary.BigEndian.PutUint32(b[4:8], uint32(defaultLifetimeSeconds(c.LifetimeSeconds, raIntervalMilliseconds)))
+	copy(b[8:], encoded)
+
+	return b, nil
+}
+
+// encodeDNSName encodes name in the DNS wire format used by the DNSSL
+// option: a sequence of length-prefixed labels terminated by a zero
+// length octet.
+func encodeDNSName(name string) ([]byte, error) {
+	var b []byte
+	for _, label := range strings.Split(strings.TrimSuffix(name, "."), ".") {
+		if len(label) == 0 || len(label) > 63 {
+			return nil, fmt.Errorf("label %q must be between 1 and 63 octets", label)
+		}
+		b = append(b, byte(len(label)))
+		b = append(b, label...)
+	}
+	return append(b, 0), nil
+}
+
+// EncodeExtraOptions encodes i's RDNSS, DNSSL and Route Information entries
+// as ICMPv6 options, in that order, ready to be appended to the Router
+// Advertisement built for i alongside its Prefix Information options. It
+// returns nil, nil if i has none of these configured.
+func (i *InterfaceConfig) EncodeExtraOptions() ([]byte, error) {
+	var b []byte
+
+	for _, r := range i.RDNSS {
+		enc, err := EncodeRDNSSOption(r, i.RAIntervalMilliseconds)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, enc...)
+	}
+
+	for _, d := range i.DNSSL {
+		enc, err := EncodeDNSSLOption(d, i.RAIntervalMilliseconds)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, enc...)
+	}
+
+	for _, route := range i.Routes {
+		enc, err := EncodeRouteInfoOption(route)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, enc...)
+	}
+
+	return b, nil
+}
+
+// EncodeRouteInfoOption encodes c as an RFC4191 Route Information ICMPv6
+// option.
+func EncodeRouteInfoOption(c *RouteConfig) ([]byte, error) {
+	prefix, err := netip.ParsePrefix(c.Prefix)
+	if err != nil {
+		return nil, fmt.Errorf("parsing route prefix %q: %w", c.Prefix, err)
+	}
+
+	prf, ok := routePreference[c.Preference]
+	if !ok {
+		return nil, fmt.Errorf("unknown route preference %q", c.Preference)
+	}
+
+	// The prefix field is omitted, 8 octets or 16 octets long depending
+	// on the prefix length, per RFC4191 section 2.3.
+	var lengthUnits, prefixBytes int
+	switch {
+	case prefix.Bits() == 0:
+		lengthUnits, prefixBytes = 1, 0
+	case prefix.Bits() <= 64:
+		lengthUnits, prefixBytes = 2, 8
+	default:
+		lengthUnits, prefixBytes = 3, 16
+	}
+
+	b := make([]byte, 8*lengthUnits)
+	b[0] = icmpv6OptRouteInformation
+	b[1] = byte(lengthUnits)
+	b[2] = byte(prefix.Bits())
+	b[3] = prf << 3
+	binary.BigEndian.PutUint32(b[4:8], uint32(defaultLifetimeSeconds(c.LifetimeSeconds, 0)))
+
+	if prefixBytes > 0 {
+		raw := prefix.Addr().As16()
+		copy(b[8:8+prefixBytes], raw[:prefixBytes])
+	}
+
+	return b, nil
+}
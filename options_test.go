@@ -0,0 +1,87 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of go-ra
+
+package ra
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeRDNSSOption(t *testing.T) {
+	lifetime := 600
+	b, err := EncodeRDNSSOption(&RDNSSConfig{
+		Addresses:       []string{"2001:db8::1", "2001:db8::2"},
+		LifetimeSeconds: &lifetime,
+	}, 300000)
+	require.NoError(t, err)
+
+	assert.Equal(t, byte(icmpv6OptRDNSS), b[0])
+	assert.Equal(t, byte(5), b[1]) // 1 + 2*2 addresses
+	assert.Len(t, b, 40)
+}
+
+func TestEncodeDNSSLOption(t *testing.T) {
+	b, err := EncodeDNSSLOption(&DNSSLConfig{
+		DomainNames: []string{"example.com"},
+	}, 300000)
+	require.NoError(t, err)
+
+	assert.Equal(t, byte(icmpv6OptDNSSL), b[0])
+	assert.Equal(t, 0, len(b)%8)
+}
+
+func TestEncodeRouteInfoOption(t *testing.T) {
+	b, err := EncodeRouteInfoOption(&RouteConfig{
+		Prefix:          "2001:db8::/32",
+		Preference:      "high",
+		LifetimeSeconds: intPtr(3600),
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, byte(icmpv6OptRouteInformation), b[0])
+	assert.Equal(t, byte(2), b[1])
+	assert.Equal(t, byte(32), b[2])
+}
+
+func TestInterfaceConfigEncodeExtraOptions(t *testing.T) {
+	iface := &InterfaceConfig{
+		Name:                   "net0",
+		RAIntervalMilliseconds: 300000,
+		RDNSS: []*RDNSSConfig{
+			{Addresses: []string{"2001:db8::1"}},
+		},
+		DNSSL: []*DNSSLConfig{
+			{DomainNames: []string{"example.com"}},
+		},
+		Routes: []*RouteConfig{
+			{Prefix: "2001:db8::/32", Preference: "high", LifetimeSeconds: intPtr(3600)},
+		},
+	}
+
+	b, err := iface.EncodeExtraOptions()
+	require.NoError(t, err)
+	require.NotEmpty(t, b)
+
+	// The three options are concatenated in RDNSS, DNSSL, Route order, each
+	// starting with its own ICMPv6 option type.
+	assert.Equal(t, byte(icmpv6OptRDNSS), b[0])
+
+	rdnss, err := EncodeRDNSSOption(iface.RDNSS[0], iface.RAIntervalMilliseconds)
+	require.NoError(t, err)
+	assert.Equal(t, byte(icmpv6OptDNSSL), b[len(rdnss)])
+
+	dnssl, err := EncodeDNSSLOption(iface.DNSSL[0], iface.RAIntervalMilliseconds)
+	require.NoError(t, err)
+	assert.Equal(t, byte(icmpv6OptRouteInformation), b[len(rdnss)+len(dnssl)])
+}
+
+func TestInterfaceConfigEncodeExtraOptionsEmpty(t *testing.T) {
+	b, err := (&InterfaceConfig{Name: "net0"}).EncodeExtraOptions()
+	require.NoError(t, err)
+	assert.Empty(t, b)
+}
+
+func intPtr(i int) *int { return &i }
@@ -0,0 +1,136 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of go-ra
+
+package ra
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+
+	"github.com/Enigamict/go-ra/metrics"
+)
+
+const defaultWatchDebounce = 200 * time.Millisecond
+
+type watchOptions struct {
+	metrics  *metrics.Metrics
+	logger   *slog.Logger
+	debounce time.Duration
+}
+
+// WatchOption configures Daemon.Watch.
+type WatchOption func(*watchOptions)
+
+// WithWatchMetrics records a reload_total{result} observation for every
+// reload triggered by Watch.
+func WithWatchMetrics(m *metrics.Metrics) WatchOption {
+	return func(o *watchOptions) {
+		o.metrics = m
+	}
+}
+
+// WithWatchLogger logs validation and reload errors encountered by Watch
+// to logger instead of slog.Default().
+func WithWatchLogger(logger *slog.Logger) WatchOption {
+	return func(o *watchOptions) {
+		o.logger = logger
+	}
+}
+
+// WithWatchDebounce overrides the default ~200ms debounce applied between
+// a filesystem event and the resulting re-parse and reload.
+func WithWatchDebounce(d time.Duration) WatchOption {
+	return func(o *watchOptions) {
+		o.debounce = d
+	}
+}
+
+// Watch watches path, a directory of the kind read by ParseConfigYAMLDir,
+// and re-parses and reloads d's configuration whenever a file under it
+// changes. Filesystem events are debounced by ~200ms to coalesce the
+// several events a single `cp`/`mv` into the directory tends to generate.
+//
+// A parse or validation error is logged (to slog.Default() unless
+// WithWatchLogger was given) and
+// recorded as reload_total{result="error"} (if WithWatchMetrics was
+// given), without stopping the watch: the daemon keeps serving its
+// last-known-good configuration. Watch returns when ctx is canceled.
+func (d *Daemon) Watch(ctx context.Context, path string, opts ...WatchOption) error {
+	o := watchOptions{
+		debounce: defaultWatchDebounce,
+		logger:   slog.Default(),
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+	defer watcher.Close()
+
+	if err := watcher.Add(path); err != nil {
+		return err
+	}
+
+	reload := func() {
+		cfg, err := ParseConfigYAMLDir(path)
+		if err != nil {
+			o.logger.Error("failed to parse config directory", "path", path, "error", err)
+			if o.metrics != nil {
+				o.metrics.Reload("error")
+			}
+			return
+		}
+
+		if err := d.Reload(ctx, cfg); err != nil {
+			o.logger.Error("failed to reload config", "path", path, "error", err)
+			if o.metrics != nil {
+				o.metrics.Reload("error")
+			}
+			return
+		}
+
+		o.logger.Info("reloaded config", "path", path)
+		if o.metrics != nil {
+			o.metrics.Reload("ok")
+		}
+	}
+
+	var timer *time.Timer
+	defer func() {
+		if timer != nil {
+			timer.Stop()
+		}
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return nil
+			}
+			if event.Op&(fsnotify.Write|fsnotify.Create|fsnotify.Remove|fsnotify.Rename) == 0 {
+				continue
+			}
+			if timer == nil {
+				timer = time.AfterFunc(o.debounce, reload)
+			} else {
+				timer.Reset(o.debounce)
+			}
+
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return nil
+			}
+			o.logger.Error("watch: fsnotify error", "error", err)
+		}
+	}
+}
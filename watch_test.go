@@ -0,0 +1,54 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of go-ra
+
+package ra
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDaemonWatchReloadsOnFileChange(t *testing.T) {
+	dir := t.TempDir()
+	confFile := filepath.Join(dir, "net0.yaml")
+	require.NoError(t, os.WriteFile(confFile, []byte(`
+interfaces:
+  - name: net0
+    raIntervalMilliseconds: 100
+`), 0o644))
+
+	reg := newFakeSockRegistry()
+
+	d, err := New(&Config{Interfaces: []*InterfaceConfig{{Name: "net0", RAIntervalMilliseconds: 100}}}, withSocketConstructor(reg.newSock))
+	require.NoError(t, err)
+
+	runCtx, cancelRun := context.WithCancel(context.Background())
+	t.Cleanup(cancelRun)
+	go d.Run(runCtx)
+
+	watchCtx, cancelWatch := context.WithCancel(context.Background())
+	t.Cleanup(cancelWatch)
+	go d.Watch(watchCtx, dir, WithWatchDebounce(10*time.Millisecond))
+
+	eventully(t, func() bool {
+		sock, err := reg.getSock("net0")
+		return assert.NoError(t, err) && assertRAInterval(t, sock, 100*time.Millisecond)
+	})
+
+	require.NoError(t, os.WriteFile(confFile, []byte(`
+interfaces:
+  - name: net0
+    raIntervalMilliseconds: 200
+`), 0o644))
+
+	eventully(t, func() bool {
+		sock, err := reg.getSock("net0")
+		return assert.NoError(t, err) && assertRAInterval(t, sock, 200*time.Millisecond)
+	})
+}